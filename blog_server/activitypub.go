@@ -0,0 +1,605 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	ap "github.com/go-ap/activitypub"
+	"github.com/go-fed/httpsig"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// federationHTTPClient is used for every outbound ActivityPub request
+// (fetching remote actors and delivering signed activities to their
+// inboxes), so that a slow or unresponsive remote peer can't hang a handler
+// goroutine indefinitely. Its transport dials through dialValidatedRemote
+// rather than the default resolver, so the address actually connected to is
+// the same one that was validated (see dialValidatedRemote).
+var federationHTTPClient = &http.Client{
+	Timeout:   10 * time.Second,
+	Transport: &http.Transport{DialContext: dialValidatedRemote},
+}
+
+// validateRemoteActorIRI rejects anything that isn't a plain https URL
+// resolving to a public address, so that an attacker-supplied actor or
+// inbox IRI in an inbound activity can't be used to make the server issue
+// requests to internal/link-local infrastructure (SSRF). This is a fast
+// upfront rejection; the actual security boundary enforced on every request
+// is dialValidatedRemote, which re-resolves and validates the address it is
+// about to dial.
+func validateRemoteActorIRI(iri string) error {
+	parsed, err := url.Parse(iri)
+	if err != nil {
+		return fmt.Errorf("invalid remote IRI: %w", err)
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("remote IRI must use https, got %q", parsed.Scheme)
+	}
+	host := parsed.Hostname()
+	if host == "" {
+		return fmt.Errorf("remote IRI has no host")
+	}
+	_, err = resolveValidatedIP(host)
+	return err
+}
+
+func isDisallowedRemoteIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast()
+}
+
+// resolveValidatedIP resolves host and returns the first address that isn't
+// loopback/private/link-local, erroring out if none qualifies.
+func resolveValidatedIP(host string) (net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		if isDisallowedRemoteIP(ip) {
+			return nil, fmt.Errorf("remote host resolves to a disallowed address: %v", ip)
+		}
+		return ip, nil
+	}
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, fmt.Errorf("can not resolve remote host %v: %w", host, err)
+	}
+	for _, ip := range ips {
+		if !isDisallowedRemoteIP(ip) {
+			return ip, nil
+		}
+	}
+	return nil, fmt.Errorf("remote host %v has no allowed address", host)
+}
+
+// dialValidatedRemote is federationHTTPClient's DialContext. It resolves
+// addr's host exactly once and dials the validated IP directly, instead of
+// letting the standard dialer re-resolve the hostname after
+// validateRemoteActorIRI already approved it — closing the DNS-rebinding
+// TOCTOU window where a malicious host returns a public address for the
+// first lookup and a private/loopback one for the second.
+func dialValidatedRemote(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ip, err := resolveValidatedIP(host)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	return dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+}
+
+// actorKeyItem stores the RSA keypair generated for a blog author so that
+// outbound activities can be signed with a stable key per actor.
+type actorKeyItem struct {
+	AuthorID   string `bson:"author_id"`
+	PrivateKey string `bson:"private_key_pem"`
+	PublicKey  string `bson:"public_key_pem"`
+}
+
+// followerItem records a single remote actor following a local author.
+type followerItem struct {
+	AuthorID   string    `bson:"author_id"`
+	ActorIRI   string    `bson:"actor_iri"`
+	InboxIRI   string    `bson:"inbox_iri"`
+	PublicKey  string    `bson:"public_key_pem"`
+	FollowedAt time.Time `bson:"followed_at"`
+}
+
+// initActivityPub starts the HTTP listener that serves webfinger/actor/
+// inbox/outbox endpoints for federated blog authors, backed by s's Mongo
+// collections.
+func initActivityPub(s *server) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/webfinger", s.handleWebfinger)
+	mux.HandleFunc("/actor/", s.handleActorRoutes)
+
+	listenAddr := s.cfg.ActivityPub.ListenAddr
+	go func() {
+		s.info(context.Background(), "ActivityPub sidecar listening", "address", listenAddr)
+		if err := http.ListenAndServe(listenAddr, mux); err != nil {
+			s.error(context.Background(), "ActivityPub sidecar stopped", "err", err)
+		}
+	}()
+}
+
+// getOrCreateActorKey returns the RSA keypair for authorID, generating and
+// persisting a new one the first time an author is federated.
+func (s *server) getOrCreateActorKey(ctx context.Context, authorID string) (*actorKeyItem, error) {
+	existing := &actorKeyItem{}
+	err := s.actorKeysCollection.FindOne(ctx, bson.M{"author_id": authorID}).Decode(existing)
+	if err == nil {
+		return existing, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("lookup actor key: %w", err)
+	}
+
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("generate RSA key: %w", err)
+	}
+	privPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privKey),
+	})
+	pubBytes, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("marshal public key: %w", err)
+	}
+	pubPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubBytes})
+
+	item := &actorKeyItem{
+		AuthorID:   authorID,
+		PrivateKey: string(privPEM),
+		PublicKey:  string(pubPEM),
+	}
+	if _, err := s.actorKeysCollection.InsertOne(ctx, item); err != nil {
+		return nil, fmt.Errorf("persist actor key: %w", err)
+	}
+	return item, nil
+}
+
+func (s *server) actorIRI(authorID string) string {
+	return fmt.Sprintf("%v/actor/%v", s.cfg.ActivityPub.BaseURL, authorID)
+}
+
+// handleWebfinger resolves acct:authorID@host lookups to the actor IRI.
+func (s *server) handleWebfinger(w http.ResponseWriter, r *http.Request) {
+	resource := r.URL.Query().Get("resource")
+	if resource == "" {
+		http.Error(w, "missing resource parameter", http.StatusBadRequest)
+		return
+	}
+	authorID := parseAcctAuthorID(resource)
+	if authorID == "" {
+		http.Error(w, "unsupported resource", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/jrd+json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"subject": resource,
+		"links": []map[string]string{
+			{"rel": "self", "type": "application/activity+json", "href": s.actorIRI(authorID)},
+		},
+	})
+}
+
+func parseAcctAuthorID(resource string) string {
+	const prefix = "acct:"
+	if len(resource) <= len(prefix) || resource[:len(prefix)] != prefix {
+		return ""
+	}
+	rest := resource[len(prefix):]
+	for i, c := range rest {
+		if c == '@' {
+			return rest[:i]
+		}
+	}
+	return rest
+}
+
+// handleActorRoutes dispatches /actor/{authorID}, /actor/{authorID}/inbox and
+// /actor/{authorID}/outbox.
+func (s *server) handleActorRoutes(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path[len("/actor/"):]
+	authorID := path
+	suffix := ""
+	for i, c := range path {
+		if c == '/' {
+			authorID = path[:i]
+			suffix = path[i:]
+			break
+		}
+	}
+	switch suffix {
+	case "":
+		s.handleActorProfile(w, r, authorID)
+	case "/inbox":
+		s.handleInbox(w, r, authorID)
+	case "/outbox":
+		s.handleOutbox(w, r, authorID)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *server) handleActorProfile(w http.ResponseWriter, r *http.Request, authorID string) {
+	key, err := s.getOrCreateActorKey(r.Context(), authorID)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("can not load actor key: %v", err), http.StatusInternalServerError)
+		return
+	}
+	actor := map[string]interface{}{
+		"@context":          []string{"https://www.w3.org/ns/activitystreams"},
+		"id":                s.actorIRI(authorID),
+		"type":              "Person",
+		"preferredUsername": authorID,
+		"inbox":             s.actorIRI(authorID) + "/inbox",
+		"outbox":            s.actorIRI(authorID) + "/outbox",
+		"publicKey": map[string]string{
+			"id":           s.actorIRI(authorID) + "#main-key",
+			"owner":        s.actorIRI(authorID),
+			"publicKeyPem": key.PublicKey,
+		},
+	}
+	w.Header().Set("Content-Type", "application/activity+json")
+	json.NewEncoder(w).Encode(actor)
+}
+
+func (s *server) handleOutbox(w http.ResponseWriter, r *http.Request, authorID string) {
+	findFilter := bson.M{"author_id": authorID}
+	cur, err := s.collection.Find(r.Context(), findFilter)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("can not list blogs: %v", err), http.StatusInternalServerError)
+		return
+	}
+	defer cur.Close(r.Context())
+	items := []interface{}{}
+	for cur.Next(r.Context()) {
+		data := &blogItem{}
+		if err := cur.Decode(data); err != nil {
+			continue
+		}
+		items = append(items, s.newCreateActivity(authorID, data))
+	}
+	w.Header().Set("Content-Type", "application/activity+json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"@context":     []string{"https://www.w3.org/ns/activitystreams"},
+		"id":           s.actorIRI(authorID) + "/outbox",
+		"type":         "OrderedCollection",
+		"totalItems":   len(items),
+		"orderedItems": items,
+	})
+}
+
+// handleInbox verifies the HTTP signature of inbound activities and handles
+// Follow, Undo(Follow) and Create (comments/mentions) side effects.
+func (s *server) handleInbox(w http.ResponseWriter, r *http.Request, authorID string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "can not read body", http.StatusBadRequest)
+		return
+	}
+	var activity map[string]interface{}
+	if err := json.Unmarshal(body, &activity); err != nil {
+		http.Error(w, "invalid activity JSON", http.StatusBadRequest)
+		return
+	}
+	actorField, _ := activity["actor"].(string)
+	if actorField == "" {
+		http.Error(w, "missing actor", http.StatusBadRequest)
+		return
+	}
+	remotePubKey, err := fetchRemoteActorPublicKey(actorField)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("can not resolve remote actor: %v", err), http.StatusBadRequest)
+		return
+	}
+	verifier, err := httpsig.NewVerifier(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("can not build signature verifier: %v", err), http.StatusBadRequest)
+		return
+	}
+	if err := verifier.Verify(remotePubKey, httpsig.RSA_SHA256); err != nil {
+		http.Error(w, fmt.Sprintf("signature verification failed: %v", err), http.StatusUnauthorized)
+		return
+	}
+
+	activityType, _ := activity["type"].(string)
+	switch activityType {
+	case "Follow":
+		s.handleInboundFollow(r.Context(), authorID, actorField, activity)
+	case "Undo":
+		s.handleInboundUndoFollow(r.Context(), authorID, actorField, activity)
+	case "Create":
+		s.handleInboundCreate(r.Context(), authorID, actorField, activity)
+	default:
+		s.info(r.Context(), "activitypub: ignoring unsupported inbound activity type", "type", activityType, "author_id", authorID)
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// handleInboundCreate records a federated reply/mention as a Comment on the
+// local blog post it targets, resolved via the object's inReplyTo IRI
+// (the same IRI newCreateActivity puts on the Note we deliver for our own
+// blogs). Activities that don't target a known local post, or carry no
+// content, are dropped.
+func (s *server) handleInboundCreate(ctx context.Context, authorID, remoteActorIRI string, activity map[string]interface{}) {
+	object, _ := activity["object"].(map[string]interface{})
+	if object == nil {
+		s.warn(ctx, "activitypub: inbound Create has no object", "author_id", authorID, "actor", remoteActorIRI)
+		return
+	}
+	inReplyTo, _ := object["inReplyTo"].(string)
+	blogID := blogIDFromIRI(inReplyTo)
+	if blogID == "" {
+		s.warn(ctx, "activitypub: inbound Create object does not reply to a known blog", "author_id", authorID, "actor", remoteActorIRI, "in_reply_to", inReplyTo)
+		return
+	}
+	blogOID, err := primitive.ObjectIDFromHex(blogID)
+	if err != nil {
+		s.warn(ctx, "activitypub: inbound Create inReplyTo has an invalid blog id", "author_id", authorID, "blog_id", blogID, "err", err)
+		return
+	}
+	if err := s.collection.FindOne(ctx, bson.M{"_id": blogOID}).Err(); err != nil {
+		s.warn(ctx, "activitypub: inbound Create targets an unknown blog", "author_id", authorID, "blog_id", blogID, "err", err)
+		return
+	}
+	content, _ := object["content"].(string)
+	if content == "" {
+		s.warn(ctx, "activitypub: inbound Create object has no content", "author_id", authorID, "actor", remoteActorIRI)
+		return
+	}
+	comment := &commentItem{
+		BlogID:    blogOID.Hex(),
+		AuthorID:  remoteActorIRI,
+		Content:   content,
+		CreatedAt: time.Now(),
+	}
+	if _, err := s.commentsCollection.InsertOne(ctx, comment); err != nil {
+		s.error(ctx, "activitypub: can not persist inbound comment", "author_id", authorID, "blog_id", blogID, "err", err)
+	}
+}
+
+// blogIDFromIRI extracts the blog ObjectID hex from an IRI shaped like
+// "<base>/blog/<id>", or "" if iri doesn't look like one of ours.
+func blogIDFromIRI(iri string) string {
+	const marker = "/blog/"
+	idx := strings.LastIndex(iri, marker)
+	if idx == -1 {
+		return ""
+	}
+	return iri[idx+len(marker):]
+}
+
+func (s *server) handleInboundFollow(ctx context.Context, authorID, remoteActorIRI string, activity map[string]interface{}) {
+	remoteActor, err := fetchRemoteActor(remoteActorIRI)
+	if err != nil {
+		s.warn(ctx, "activitypub: can not fetch remote actor", "actor", remoteActorIRI, "err", err)
+		return
+	}
+	follower := followerItem{
+		AuthorID:   authorID,
+		ActorIRI:   remoteActorIRI,
+		InboxIRI:   remoteActor.Inbox,
+		PublicKey:  remoteActor.PublicKeyPEM,
+		FollowedAt: time.Now(),
+	}
+	_, err = s.followersCollection.UpdateOne(ctx,
+		bson.M{"author_id": authorID, "actor_iri": remoteActorIRI},
+		bson.M{"$set": follower},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		s.error(ctx, "activitypub: can not persist follower", "actor", remoteActorIRI, "err", err)
+		return
+	}
+	key, err := s.getOrCreateActorKey(ctx, authorID)
+	if err != nil {
+		s.error(ctx, "activitypub: can not load actor key for accept", "author_id", authorID, "err", err)
+		return
+	}
+	accept := map[string]interface{}{
+		"@context": []string{"https://www.w3.org/ns/activitystreams"},
+		"type":     "Accept",
+		"actor":    s.actorIRI(authorID),
+		"object":   activity,
+	}
+	if err := sendSignedActivity(s.actorIRI(authorID), authorID, key, remoteActor.Inbox, accept); err != nil {
+		s.warn(ctx, "activitypub: can not deliver Accept", "actor", remoteActorIRI, "err", err)
+	}
+}
+
+func (s *server) handleInboundUndoFollow(ctx context.Context, authorID, remoteActorIRI string, activity map[string]interface{}) {
+	object, _ := activity["object"].(map[string]interface{})
+	if object == nil || object["type"] != "Follow" {
+		return
+	}
+	if _, err := s.followersCollection.DeleteOne(ctx, bson.M{"author_id": authorID, "actor_iri": remoteActorIRI}); err != nil {
+		s.warn(ctx, "activitypub: can not remove follower", "actor", remoteActorIRI, "err", err)
+	}
+}
+
+type remoteActor struct {
+	Inbox        string
+	PublicKeyPEM string
+}
+
+func fetchRemoteActor(iri string) (*remoteActor, error) {
+	if err := validateRemoteActorIRI(iri); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodGet, iri, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/activity+json")
+	resp, err := federationHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var parsed struct {
+		Inbox     string `json:"inbox"`
+		PublicKey struct {
+			PublicKeyPem string `json:"publicKeyPem"`
+		} `json:"publicKey"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+	return &remoteActor{Inbox: parsed.Inbox, PublicKeyPEM: parsed.PublicKey.PublicKeyPem}, nil
+}
+
+func fetchRemoteActorPublicKey(iri string) (*rsa.PublicKey, error) {
+	remote, err := fetchRemoteActor(iri)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode([]byte(remote.PublicKeyPEM))
+	if block == nil {
+		return nil, fmt.Errorf("remote actor %v has no usable public key", iri)
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("remote actor %v public key is not RSA", iri)
+	}
+	return rsaPub, nil
+}
+
+func (s *server) newCreateActivity(authorID string, blog *blogItem) *ap.Create {
+	baseURL := s.cfg.ActivityPub.BaseURL
+	note := ap.ObjectNew(ap.NoteType)
+	note.ID = ap.IRI(fmt.Sprintf("%v/blog/%v", baseURL, blog.ID.Hex()))
+	note.Name = ap.NaturalLanguageValuesNew()
+	note.Name.Append(ap.NilLangRef, blog.Title)
+	note.Content = ap.NaturalLanguageValuesNew()
+	note.Content.Append(ap.NilLangRef, blog.Content)
+	note.AttributedTo = ap.IRI(s.actorIRI(authorID))
+
+	create := ap.CreateNew(ap.IRI(fmt.Sprintf("%v/activity/create-%v", baseURL, blog.ID.Hex())), note)
+	create.Actor = ap.IRI(s.actorIRI(authorID))
+	return create
+}
+
+// notifyFollowers builds a signed activity for blog and enqueues delivery to
+// every follower inbox of authorID, retrying with exponential backoff.
+func (s *server) notifyFollowers(ctx context.Context, authorID string, blog *blogItem, activityType string) {
+	if !s.cfg.ActivityPub.Enabled {
+		return
+	}
+	key, err := s.getOrCreateActorKey(ctx, authorID)
+	if err != nil {
+		s.error(ctx, "activitypub: notifyFollowers can not load actor key", "author_id", authorID, "err", err)
+		return
+	}
+	var activity interface{}
+	switch activityType {
+	case "Create":
+		activity = s.newCreateActivity(authorID, blog)
+	case "Update", "Delete":
+		activity = map[string]interface{}{
+			"@context": []string{"https://www.w3.org/ns/activitystreams"},
+			"type":     activityType,
+			"actor":    s.actorIRI(authorID),
+			"object":   fmt.Sprintf("%v/blog/%v", s.cfg.ActivityPub.BaseURL, blog.ID.Hex()),
+		}
+	default:
+		return
+	}
+
+	cur, err := s.followersCollection.Find(ctx, bson.M{"author_id": authorID})
+	if err != nil {
+		s.error(ctx, "activitypub: notifyFollowers can not list followers", "author_id", authorID, "err", err)
+		return
+	}
+	defer cur.Close(ctx)
+	actorIRI := s.actorIRI(authorID)
+	for cur.Next(ctx) {
+		follower := &followerItem{}
+		if err := cur.Decode(follower); err != nil {
+			continue
+		}
+		go s.sendSignedActivityWithRetry(ctx, actorIRI, authorID, key, follower.InboxIRI, activity)
+	}
+}
+
+func (s *server) sendSignedActivityWithRetry(ctx context.Context, actorIRI, authorID string, key *actorKeyItem, inboxIRI string, activity interface{}) {
+	const maxAttempts = 5
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err := sendSignedActivity(actorIRI, authorID, key, inboxIRI, activity); err == nil {
+			return
+		}
+		backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+		time.Sleep(backoff)
+	}
+	s.warn(ctx, "activitypub: giving up delivering activity", "inbox", inboxIRI, "attempts", maxAttempts)
+}
+
+func sendSignedActivity(actorIRI, authorID string, key *actorKeyItem, inboxIRI string, activity interface{}) error {
+	if err := validateRemoteActorIRI(inboxIRI); err != nil {
+		return fmt.Errorf("refusing to deliver to inbox: %w", err)
+	}
+	payload, err := json.Marshal(activity)
+	if err != nil {
+		return fmt.Errorf("marshal activity: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, inboxIRI, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/activity+json")
+
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return fmt.Errorf("actor %v has no usable private key", authorID)
+	}
+	privKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("parse actor private key: %w", err)
+	}
+	signer, _, err := httpsig.NewSigner(
+		[]httpsig.Algorithm{httpsig.RSA_SHA256},
+		httpsig.DigestSha256,
+		[]string{httpsig.RequestTarget, "host", "date", "digest"},
+		httpsig.Signature,
+		0,
+	)
+	if err != nil {
+		return fmt.Errorf("build signer: %w", err)
+	}
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	if err := signer.SignRequest(privKey, actorIRI+"#main-key", req, payload); err != nil {
+		return fmt.Errorf("sign request: %w", err)
+	}
+
+	resp, err := federationHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver to %v: %w", inboxIRI, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("inbox %v rejected activity with status %v", inboxIRI, resp.StatusCode)
+	}
+	return nil
+}
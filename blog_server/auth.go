@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/thogtq/grpc-blog-service/m/v1/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authUser is the authenticated principal extracted from a bearer JWT,
+// carried on the context for handlers to authorize against.
+type authUser struct {
+	Subject string
+	IsAdmin bool
+}
+
+type authUserCtxKeyType struct{}
+
+var authUserCtxKey = authUserCtxKeyType{}
+
+func authUserFromContext(ctx context.Context) (*authUser, bool) {
+	u, ok := ctx.Value(authUserCtxKey).(*authUser)
+	return u, ok
+}
+
+// requireAuthorOrAdmin enforces that the authenticated caller either is
+// authorID or carries the admin claim, mirroring the check CreateBlog/
+// UpdateBlog/DeleteBlog must perform before mutating someone else's blog.
+func requireAuthorOrAdmin(ctx context.Context, authorID string) error {
+	user, ok := authUserFromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "missing authenticated user")
+	}
+	if user.IsAdmin || user.Subject == authorID {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "authenticated user %v may not act as author %v", user.Subject, authorID)
+}
+
+// loadServerTLSConfig builds the TLS configuration for the gRPC listener
+// from cfg.CertFile/cfg.KeyFile and, when mTLS is requested, a client CA
+// bundle at cfg.ClientCAFile.
+func loadServerTLSConfig(cfg *config.TLSConfig) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("server.tls.cert_file and server.tls.key_file must both be set when TLS is enabled")
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server keypair: %w", err)
+	}
+	tlsCfg := &tls.Config{Certificates: []tls.Certificate{cert}}
+
+	if cfg.ClientCAFile != "" {
+		caBytes, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA bundle: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBytes) {
+			return nil, fmt.Errorf("no certificates found in %v", cfg.ClientCAFile)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsCfg, nil
+}
+
+func newServerTLSCredentials(cfg *config.TLSConfig) (credentials.TransportCredentials, error) {
+	tlsCfg, err := loadServerTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// authUnaryInterceptor validates the bearer JWT carried in the
+// "authorization" metadata (if any) — against cfg.JWTSigningKey for HMAC
+// tokens, or jwks for RS256 tokens — and, on success, attaches the
+// resulting authUser to the context. It does not itself reject
+// unauthenticated calls: read-only RPCs remain open, while
+// CreateBlog/UpdateBlog/DeleteBlog call requireAuthorOrAdmin to enforce
+// ownership.
+func authUnaryInterceptor(cfg *config.AuthConfig, jwks *jwksKeySet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		authedCtx, err := authenticate(ctx, cfg, jwks)
+		if err != nil {
+			return nil, err
+		}
+		return handler(authedCtx, req)
+	}
+}
+
+func authStreamInterceptor(cfg *config.AuthConfig, jwks *jwksKeySet) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		authedCtx, err := authenticate(ss.Context(), cfg, jwks)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &loggedServerStream{ServerStream: ss, ctx: authedCtx})
+	}
+}
+
+func authenticate(ctx context.Context, cfg *config.AuthConfig, jwks *jwksKeySet) (context.Context, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return ctx, nil
+	}
+	if cfg.JWTSigningKey == "" && jwks == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "server is not configured to validate bearer tokens")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		switch t.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if cfg.JWTSigningKey == "" {
+				return nil, fmt.Errorf("server is not configured with a JWT signing key")
+			}
+			return []byte(cfg.JWTSigningKey), nil
+		case *jwt.SigningMethodRSA:
+			if jwks == nil {
+				return nil, fmt.Errorf("server is not configured with a JWKS URL")
+			}
+			kid, _ := t.Header["kid"].(string)
+			if kid == "" {
+				return nil, fmt.Errorf("RS256 token is missing a kid header")
+			}
+			return jwks.publicKey(ctx, kid)
+		default:
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid bearer token: %v", err)
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "bearer token is missing a sub claim")
+	}
+	isAdmin, _ := claims["admin"].(bool)
+
+	user := &authUser{Subject: subject, IsAdmin: isAdmin}
+	return context.WithValue(ctx, authUserCtxKey, user), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	const prefix = "Bearer "
+	if !strings.HasPrefix(values[0], prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(values[0], prefix)
+}
+
+// jwksCacheTTL bounds how long fetched JWKS keys are trusted before
+// jwksKeySet re-fetches them, so a rotated signing key is picked up without
+// a restart.
+const jwksCacheTTL = 10 * time.Minute
+
+// jwksKeySet lazily fetches and caches RSA public keys from a JWKS endpoint,
+// keyed by "kid", so RS256 bearer tokens can be validated without a
+// round trip to the JWKS URL on every request.
+type jwksKeySet struct {
+	url        string
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	fetchedAt time.Time
+}
+
+// newJWKSKeySet returns nil if url is empty, so callers can treat "no JWKS
+// configured" and "no JWKS URL set" the same way.
+func newJWKSKeySet(url string) *jwksKeySet {
+	if url == "" {
+		return nil
+	}
+	return &jwksKeySet{url: url, httpClient: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (k *jwksKeySet) publicKey(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if key, ok := k.keys[kid]; ok && time.Since(k.fetchedAt) < jwksCacheTTL {
+		return key, nil
+	}
+	if err := k.refreshLocked(ctx); err != nil {
+		return nil, err
+	}
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("no JWKS key found for kid %v", kid)
+	}
+	return key, nil
+}
+
+func (k *jwksKeySet) refreshLocked(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return fmt.Errorf("build JWKS request: %w", err)
+	}
+	resp, err := k.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch JWKS: unexpected status %v", resp.StatusCode)
+	}
+
+	var parsed struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return fmt.Errorf("decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(parsed.Keys))
+	for _, jwk := range parsed.Keys {
+		if jwk.Kty != "RSA" || jwk.Kid == "" {
+			continue
+		}
+		pub, err := rsaPublicKeyFromJWK(jwk.N, jwk.E)
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = pub
+	}
+	k.keys = keys
+	k.fetchedAt = time.Now()
+	return nil
+}
+
+func rsaPublicKeyFromJWK(nEncoded, eEncoded string) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(nEncoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode modulus: %w", err)
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(eEncoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode exponent: %w", err)
+	}
+	e := 0
+	for _, b := range eBytes {
+		e = e<<8 | int(b)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(nBytes), E: e}, nil
+}
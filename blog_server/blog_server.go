@@ -2,24 +2,54 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
+	"regexp"
+	"syscall"
 	"time"
 
 	"github.com/thogtq/grpc-blog-service/m/v1/blogpb"
+	"github.com/thogtq/grpc-blog-service/m/v1/config"
+	"github.com/thogtq/grpc-blog-service/m/v1/migrations"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
-type server struct{}
+// gracefulShutdownTimeout bounds how long we wait for in-flight RPCs (and
+// long-lived streams such as ListBlog/WatchComments) to drain before we give
+// up and forcibly close every connection.
+const gracefulShutdownTimeout = 30 * time.Second
+
+// mongoHealthProbeInterval controls how often we ping Mongo to decide
+// whether the health service should report SERVING.
+const mongoHealthProbeInterval = 15 * time.Second
+
+// server implements blogpb.BlogServiceServer. All of its dependencies are
+// threaded in through newServer rather than read from package globals, so
+// the service can be constructed and tested against different configs and
+// collections.
+type server struct {
+	cfg                 *config.Config
+	logger              *slog.Logger
+	collection          *mongo.Collection
+	commentsCollection  *mongo.Collection
+	actorKeysCollection *mongo.Collection
+	followersCollection *mongo.Collection
+}
+
 type blogItem struct {
 	ID       primitive.ObjectID `bson:"_id,omitempty"`
 	AuthorID string             `bson:"author_id"`
@@ -27,77 +57,187 @@ type blogItem struct {
 	Title    string             `bson:"title"`
 }
 
-var collection *mongo.Collection
-
-const secureConnection = false
-const serverPort = "50051"
-const serverAddress = "0.0.0.0:"
+// newServer wires up a server against an already-connected Mongo database,
+// using cfg for everything that used to be a hardcoded constant.
+func newServer(cfg *config.Config, db *mongo.Database, logger *slog.Logger) *server {
+	s := &server{
+		cfg:                 cfg,
+		logger:              logger,
+		collection:          db.Collection(cfg.Mongo.BlogCollection),
+		commentsCollection:  db.Collection("comments"),
+		actorKeysCollection: db.Collection("actor_keys"),
+		followersCollection: db.Collection("followers"),
+	}
+	if cfg.ActivityPub.Enabled {
+		initActivityPub(s)
+	}
+	return s
+}
 
 func main() {
-	//logging line of code causes server crashing
-	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	migrateOnly := flag.Bool("migrate-only", false, "apply pending schema migrations and exit without starting the gRPC server")
+	flag.Parse()
 
-	//Mongodb connection setup
-	client, err := mongo.NewClient(options.Client().ApplyURI("mongodb://localhost:27017"))
+	cfg, err := config.Load()
 	if err != nil {
-		log.Fatalf("error when creating mongodb client")
+		fmt.Printf("can not load config: %v\n", err)
+		os.Exit(1)
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	if err := cfg.Validate(); err != nil {
+		fmt.Printf("invalid config: %v\n", err)
+		os.Exit(1)
+	}
+	logger := newLogger(&cfg.Logging)
+	logger.Info("starting blog service", "config", cfg.Redacted())
+
+	//Mongodb connection setup
+	client, mongoErr := mongo.NewClient(options.Client().ApplyURI(cfg.Mongo.URI))
+	if mongoErr != nil {
+		logger.Error("error when creating mongodb client", "err", mongoErr)
+		os.Exit(1)
+	}
+	connectTimeout := time.Duration(cfg.Mongo.ConnectTimeoutSeconds) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
 	defer cancel()
-	err = client.Connect(ctx)
-	if err != nil {
-		log.Fatalf("can not connect to mongodb server")
+	if err := client.Connect(ctx); err != nil {
+		logger.Error("can not connect to mongodb server", "err", err)
+		os.Exit(1)
+	}
+	logger.Info("connected to MongoDB", "uri", cfg.Redacted().Mongo.URI, "database", cfg.Mongo.Database)
+
+	db := client.Database(cfg.Mongo.Database)
+	logger.Info("applying schema migrations")
+	migrationTimeout := time.Duration(cfg.Mongo.MigrationTimeoutSeconds) * time.Second
+	migrationCtx, migrationCancel := context.WithTimeout(context.Background(), migrationTimeout)
+	migrationErr := migrations.Run(migrationCtx, db, cfg.Mongo.BlogCollection)
+	migrationCancel()
+	if migrationErr != nil {
+		logger.Error("schema migrations failed", "err", migrationErr)
+		os.Exit(1)
+	}
+	logger.Info("schema migrations applied")
+	if *migrateOnly {
+		disconnectCtx, disconnectCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer disconnectCancel()
+		client.Disconnect(disconnectCtx)
+		return
 	}
-	collection = client.Database("mydb").Collection("blog")
-	fmt.Println("Connected to MongoDB")
 
-	listen, listenErr := net.Listen("tcp", serverAddress+serverPort)
+	srv := newServer(cfg, db, logger)
+
+	address := cfg.Server.Address + ":" + cfg.Server.Port
+	listen, listenErr := net.Listen("tcp", address)
 	if listenErr != nil {
-		log.Fatalf("error while listen tcp at %v%v", serverAddress, serverPort)
+		logger.Error("error while listening", "address", address, "err", listenErr)
 		return
 	}
 
 	//gRPC server setup
-	serverOpts := []grpc.ServerOption{}
-	if secureConnection {
-		//establish TSL connection
+	jwks := newJWKSKeySet(cfg.Auth.JWKSURL)
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(loggingUnaryInterceptor(logger), authUnaryInterceptor(&cfg.Auth, jwks)),
+		grpc.ChainStreamInterceptor(loggingStreamInterceptor(logger), authStreamInterceptor(&cfg.Auth, jwks)),
+	}
+	if cfg.Server.TLS.Enabled {
+		tlsCreds, tlsErr := newServerTLSCredentials(&cfg.Server.TLS)
+		if tlsErr != nil {
+			logger.Error("can not load TLS credentials", "err", tlsErr)
+			os.Exit(1)
+		}
+		serverOpts = append(serverOpts, grpc.Creds(tlsCreds))
 	}
 	serverControl := grpc.NewServer(serverOpts...)
-	blogpb.RegisterBlogServiceServer(serverControl, &server{})
-	fmt.Println("Blog Service started")
+	blogpb.RegisterBlogServiceServer(serverControl, srv)
+
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(serverControl, healthServer)
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+
+	stopHealthProbe := make(chan struct{})
+	go probeMongoHealth(client, healthServer, logger, stopHealthProbe)
+
+	logger.Info("blog service started", "address", address)
 	//The *Server.Serve() function will block the program so we run it in a goroutine
 	go func() {
-		fmt.Println("Server started")
 		serveErr := serverControl.Serve(listen)
 		//Blocked if successfully serve
 		if serveErr != nil {
-			log.Fatalf("fail to serve server : %v", serveErr)
+			logger.Error("fail to serve server", "err", serveErr)
+			os.Exit(1)
 		}
 	}()
 
-	//Setup shutdown hook for server when Ctrl + C
+	//Setup shutdown hook for server when Ctrl + C or SIGTERM (e.g. from a container orchestrator)
 	shutdownCh := make(chan os.Signal, 1)
-	signal.Notify(shutdownCh, os.Interrupt)
+	signal.Notify(shutdownCh, os.Interrupt, syscall.SIGTERM)
 	//Block until shutdown signal is received
 	<-shutdownCh
-	//Prepare and shut down server
-	fmt.Printf("\nStopping the server...\n")
-	serverControl.Stop()
-	fmt.Println("Closing the listener...")
+
+	//Stop routing new traffic to us before we start draining
+	logger.Info("shutting down: marking service NOT_SERVING")
+	healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	close(stopHealthProbe)
+
+	logger.Info("draining in-flight RPCs", "timeout", gracefulShutdownTimeout)
+	drained := make(chan struct{})
+	go func() {
+		serverControl.GracefulStop()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		logger.Info("all RPCs drained")
+	case <-time.After(gracefulShutdownTimeout):
+		logger.Warn("graceful stop timed out, forcing shutdown")
+		serverControl.Stop()
+	}
+
+	logger.Info("closing the listener")
 	listen.Close()
-	fmt.Println("Disconnecting from mongodb...")
-	client.Disconnect(ctx)
-	fmt.Println("Server shutdown reached")
+
+	logger.Info("disconnecting from mongodb")
+	disconnectCtx, disconnectCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer disconnectCancel()
+	client.Disconnect(disconnectCtx)
+	logger.Info("server shutdown reached")
+}
+
+// probeMongoHealth periodically pings Mongo and reflects the result in
+// healthServer, so that a load balancer stops sending traffic if the
+// database becomes unreachable even while the gRPC server itself is fine.
+func probeMongoHealth(client *mongo.Client, healthServer *health.Server, logger *slog.Logger, stop <-chan struct{}) {
+	ticker := time.NewTicker(mongoHealthProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			pingCtx, cancel := context.WithTimeout(context.Background(), mongoHealthProbeInterval/2)
+			err := client.Ping(pingCtx, nil)
+			cancel()
+			if err != nil {
+				logger.Warn("mongodb health probe failed", "err", err)
+				healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+				continue
+			}
+			healthServer.SetServingStatus("", grpc_health_v1.HealthCheckResponse_SERVING)
+		}
+	}
 }
-func (*server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
+func (s *server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*blogpb.CreateBlogResponse, error) {
 	blog := req.GetBlog()
+	if err := requireAuthorOrAdmin(ctx, blog.GetAuthorId()); err != nil {
+		return nil, err
+	}
 	insertData := blogItem{
 		AuthorID: blog.GetAuthorId(),
 		Title:    blog.GetTitle(),
 		Content:  blog.GetContent(),
 	}
-	resp, insertErr := collection.InsertOne(context.Background(), insertData)
+	resp, insertErr := s.collection.InsertOne(context.Background(), insertData)
 	if insertErr != nil {
+		s.error(ctx, "CreateBlog: insert failed", "err", insertErr, "author_id", insertData.AuthorID)
 		return nil, status.Errorf(
 			codes.Internal,
 			fmt.Sprintf("Interal error: %v", insertErr),
@@ -105,11 +245,15 @@ func (*server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*
 	}
 	blogID, ok := resp.InsertedID.(primitive.ObjectID)
 	if !ok {
+		s.error(ctx, "CreateBlog: inserted ID was not an ObjectID", "author_id", insertData.AuthorID)
 		return nil, status.Errorf(
 			codes.Internal,
 			fmt.Sprintf("Can not convert to ObjectID: %v", insertErr),
 		)
 	}
+	insertData.ID = blogID
+	go s.notifyFollowers(context.Background(), insertData.AuthorID, &insertData, "Create")
+
 	return &blogpb.CreateBlogResponse{
 		Blog: &blogpb.Blog{
 			Id:       blogID.Hex(),
@@ -120,7 +264,7 @@ func (*server) CreateBlog(ctx context.Context, req *blogpb.CreateBlogRequest) (*
 	}, nil
 
 }
-func (*server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
+func (s *server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blogpb.ReadBlogResponse, error) {
 	blogID := req.GetBlogId()
 	oID, err := primitive.ObjectIDFromHex(blogID)
 	if err != nil {
@@ -132,8 +276,9 @@ func (*server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blog
 	data := &blogItem{}
 	findFilter := bson.M{"_id": oID}
 	findOpts := []*options.FindOneOptions{}
-	res := collection.FindOne(context.Background(), findFilter, findOpts...)
+	res := s.collection.FindOne(context.Background(), findFilter, findOpts...)
 	if err := res.Decode(data); err != nil {
+		s.warn(ctx, "ReadBlog: blog not found", "err", err, "blog_id", blogID)
 		return nil, status.Errorf(
 			codes.NotFound,
 			fmt.Sprintf("Blog not found: %v", err),
@@ -143,8 +288,11 @@ func (*server) ReadBlog(ctx context.Context, req *blogpb.ReadBlogRequest) (*blog
 		Blog: blogItemToBlogpb(data),
 	}, nil
 }
-func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
+func (s *server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*blogpb.UpdateBlogResponse, error) {
 	blog := req.GetBlog()
+	if err := requireAuthorOrAdmin(ctx, blog.GetAuthorId()); err != nil {
+		return nil, err
+	}
 	blogID, err := primitive.ObjectIDFromHex(blog.GetId())
 	if err != nil {
 		return nil, status.Errorf(
@@ -152,6 +300,20 @@ func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*
 			fmt.Sprintf("Can not parse blog ID: %v", err),
 		)
 	}
+	existing := &blogItem{}
+	switch err := s.collection.FindOne(context.Background(), bson.M{"_id": blogID}).Decode(existing); err {
+	case nil:
+		if err := requireAuthorOrAdmin(ctx, existing.AuthorID); err != nil {
+			return nil, err
+		}
+	case mongo.ErrNoDocuments:
+	default:
+		s.error(ctx, "UpdateBlog: lookup failed", "err", err, "blog_id", blog.GetId())
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Can not update blog : %v", err),
+		)
+	}
 	data := &blogItem{
 		AuthorID: blog.GetAuthorId(),
 		Title:    blog.GetTitle(),
@@ -159,14 +321,17 @@ func (*server) UpdateBlog(ctx context.Context, req *blogpb.UpdateBlogRequest) (*
 	}
 	replaceFilter := bson.M{"_id": blogID}
 	replaceOpts := []*options.ReplaceOptions{}
-	_, updateErr := collection.ReplaceOne(context.Background(), replaceFilter, data, replaceOpts...)
+	_, updateErr := s.collection.ReplaceOne(context.Background(), replaceFilter, data, replaceOpts...)
 	if updateErr != nil {
+		s.error(ctx, "UpdateBlog: replace failed", "err", updateErr, "blog_id", blog.GetId())
 		return nil, status.Errorf(
 			codes.NotFound,
 			fmt.Sprintf("Can not update blog : %v", updateErr),
 		)
 	}
 	data.ID = blogID
+	go s.notifyFollowers(context.Background(), data.AuthorID, data, "Update")
+
 	return &blogpb.UpdateBlogResponse{
 		Blog: blogItemToBlogpb(data),
 	}, nil
@@ -179,7 +344,7 @@ func blogItemToBlogpb(item *blogItem) *blogpb.Blog {
 		Content:  item.Content,
 	}
 }
-func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
+func (s *server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*blogpb.DeleteBlogResponse, error) {
 	oID, parseErr := primitive.ObjectIDFromHex(req.GetBlogId())
 	if parseErr != nil {
 		return nil, status.Errorf(
@@ -188,8 +353,25 @@ func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*
 		)
 	}
 	deleteFilter := bson.M{"_id": oID}
-	res, deleteErr := collection.DeleteOne(context.Background(), deleteFilter)
+	existing := &blogItem{}
+	switch err := s.collection.FindOne(context.Background(), deleteFilter).Decode(existing); err {
+	case nil:
+		if err := requireAuthorOrAdmin(ctx, existing.AuthorID); err != nil {
+			return nil, err
+		}
+	case mongo.ErrNoDocuments:
+		// Nothing to authorize against; DeleteOne below removes 0 rows.
+	default:
+		s.error(ctx, "DeleteBlog: lookup failed", "err", err, "blog_id", req.GetBlogId())
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Can not delete blog : %v", err),
+		)
+	}
+
+	res, deleteErr := s.collection.DeleteOne(context.Background(), deleteFilter)
 	if deleteErr != nil {
+		s.error(ctx, "DeleteBlog: delete failed", "err", deleteErr, "blog_id", req.GetBlogId())
 		return nil, status.Errorf(
 			codes.Internal,
 			fmt.Sprintf("Can not delete blog : %v", deleteErr),
@@ -201,13 +383,89 @@ func (*server) DeleteBlog(ctx context.Context, req *blogpb.DeleteBlogRequest) (*
 			fmt.Sprintf("Not found blog with provided ID"),
 		)
 	}
+	if existing.AuthorID != "" {
+		existing.ID = oID
+		go s.notifyFollowers(context.Background(), existing.AuthorID, existing, "Delete")
+	}
 	return &blogpb.DeleteBlogResponse{
 		BlogId: req.GetBlogId(),
 	}, nil
 }
-func (*server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
-	findFilter := primitive.D{{}}
-	cur, err := collection.Find(context.Background(), findFilter)
+const defaultListBlogPageSize = 20
+const maxListBlogPageSize = 100
+
+func (s *server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_ListBlogServer) error {
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultListBlogPageSize
+	}
+	if pageSize < 0 || pageSize > maxListBlogPageSize {
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("page_size must be between 1 and %v, got %v", maxListBlogPageSize, pageSize),
+		)
+	}
+
+	findFilter := bson.M{}
+	if authorID := req.GetAuthorId(); authorID != "" {
+		findFilter["author_id"] = authorID
+	}
+	if titleContains := req.GetTitleContains(); titleContains != "" {
+		findFilter["title"] = bson.M{"$regex": primitive.Regex{Pattern: regexp.QuoteMeta(titleContains), Options: "i"}}
+	}
+
+	sortField := req.GetSortBy()
+	if sortField == "" {
+		sortField = "_id"
+	}
+	switch sortField {
+	case "_id", "author_id", "title", "content":
+	default:
+		return status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("sort_by must be one of _id, author_id, title, content, got %q", sortField),
+		)
+	}
+	sortDirection := 1
+	cmpOp := "$gt"
+	if req.GetSortOrder() == "desc" {
+		sortDirection = -1
+		cmpOp = "$lt"
+	}
+
+	if req.GetPageToken() != "" {
+		token, err := decodeListBlogPageToken(req.GetPageToken())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid page_token: %v", err))
+		}
+		lastID, err := primitive.ObjectIDFromHex(token.LastID)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid page_token: %v", err))
+		}
+		if sortField == "_id" {
+			findFilter["_id"] = bson.M{cmpOp: lastID}
+		} else {
+			// Keyset pagination on a non-unique sort field: resume after the
+			// exact (sort value, _id) pair we last sent, using _id as a
+			// tiebreaker among rows that share the same sort value.
+			findFilter["$or"] = bson.A{
+				bson.M{sortField: bson.M{cmpOp: token.SortValue}},
+				bson.M{sortField: token.SortValue, "_id": bson.M{"$gt": lastID}},
+			}
+		}
+	}
+
+	sortSpec := bson.D{{Key: sortField, Value: sortDirection}}
+	if sortField != "_id" {
+		// _id tiebreaker so rows sharing a sort value still come back in a
+		// stable order that matches the keyset comparison above.
+		sortSpec = append(sortSpec, bson.E{Key: "_id", Value: 1})
+	}
+	findOpts := options.Find().
+		SetSort(sortSpec).
+		SetLimit(int64(pageSize))
+
+	cur, err := s.collection.Find(context.Background(), findFilter, findOpts)
 	if err != nil {
 		return status.Errorf(
 			codes.Internal,
@@ -215,6 +473,9 @@ func (*server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_L
 		)
 	}
 	defer cur.Close(context.Background())
+	var lastSeen primitive.ObjectID
+	var lastSortValue string
+	var sent int32
 	for cur.Next(context.Background()) {
 		data := &blogItem{}
 		err := cur.Decode(data)
@@ -224,6 +485,9 @@ func (*server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_L
 				fmt.Sprintf("unable to decode data : %v", err),
 			)
 		}
+		lastSeen = data.ID
+		lastSortValue = blogItemSortValue(data, sortField)
+		sent++
 		stream.Send(&blogpb.ListBlogResponse{
 			Blog: blogItemToBlogpb(data),
 		})
@@ -234,5 +498,54 @@ func (*server) ListBlog(req *blogpb.ListBlogRequest, stream blogpb.BlogService_L
 			fmt.Sprintf("internal error from cursor: %v", err),
 		)
 	}
+	if sent == pageSize {
+		stream.Send(&blogpb.ListBlogResponse{
+			NextPageToken: encodeListBlogPageToken(lastSortValue, lastSeen),
+		})
+	}
 	return nil
 }
+
+// blogItemSortValue returns item's value for sortField, as used both to
+// build the next page_token and, via decodeListBlogPageToken, to resume
+// pagination on that same field.
+func blogItemSortValue(item *blogItem, sortField string) string {
+	switch sortField {
+	case "author_id":
+		return item.AuthorID
+	case "title":
+		return item.Title
+	case "content":
+		return item.Content
+	default:
+		return item.ID.Hex()
+	}
+}
+
+// listBlogPageToken carries both the last sort-field value and the last _id
+// seen, so ListBlog can resume correctly regardless of sort_by/sort_order
+// instead of always comparing against _id.
+type listBlogPageToken struct {
+	SortValue string `json:"sort_value"`
+	LastID    string `json:"last_id"`
+}
+
+// encodeListBlogPageToken and decodeListBlogPageToken turn the last seen
+// (sort value, _id) pair into an opaque page_token so callers never depend
+// on its concrete encoding.
+func encodeListBlogPageToken(sortValue string, lastID primitive.ObjectID) string {
+	data, _ := json.Marshal(listBlogPageToken{SortValue: sortValue, LastID: lastID.Hex()})
+	return base64.URLEncoding.EncodeToString(data)
+}
+
+func decodeListBlogPageToken(token string) (listBlogPageToken, error) {
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return listBlogPageToken{}, err
+	}
+	var t listBlogPageToken
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return listBlogPageToken{}, err
+	}
+	return t, nil
+}
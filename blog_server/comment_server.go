@@ -0,0 +1,243 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/thogtq/grpc-blog-service/m/v1/blogpb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// commentItem is the Mongo-side representation of a blogpb.Comment.
+type commentItem struct {
+	ID              primitive.ObjectID `bson:"_id,omitempty"`
+	BlogID          string             `bson:"blog_id"`
+	ParentCommentID string             `bson:"parent_comment_id,omitempty"`
+	AuthorID        string             `bson:"author_id"`
+	Content         string             `bson:"content"`
+	CreatedAt       time.Time          `bson:"created_at"`
+}
+
+
+func commentItemToBlogpb(item *commentItem) *blogpb.Comment {
+	return &blogpb.Comment{
+		Id:              item.ID.Hex(),
+		BlogId:          item.BlogID,
+		ParentCommentId: item.ParentCommentID,
+		AuthorId:        item.AuthorID,
+		Content:         item.Content,
+		CreatedAt:       item.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (s *server) CreateComment(ctx context.Context, req *blogpb.CreateCommentRequest) (*blogpb.CreateCommentResponse, error) {
+	comment := req.GetComment()
+	if err := requireAuthorOrAdmin(ctx, comment.GetAuthorId()); err != nil {
+		return nil, err
+	}
+	blogOID, err := primitive.ObjectIDFromHex(comment.GetBlogId())
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Invalid blog ID: %v", err),
+		)
+	}
+	if err := s.collection.FindOne(ctx, bson.M{"_id": blogOID}).Err(); err != nil {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Blog not found: %v", err),
+		)
+	}
+	if comment.GetParentCommentId() != "" {
+		if _, err := primitive.ObjectIDFromHex(comment.GetParentCommentId()); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				fmt.Sprintf("Invalid parent comment ID: %v", err),
+			)
+		}
+	}
+
+	insertData := commentItem{
+		BlogID:          comment.GetBlogId(),
+		ParentCommentID: comment.GetParentCommentId(),
+		AuthorID:        comment.GetAuthorId(),
+		Content:         comment.GetContent(),
+		CreatedAt:       time.Now().UTC(),
+	}
+	resp, insertErr := s.commentsCollection.InsertOne(ctx, insertData)
+	if insertErr != nil {
+		s.error(ctx, "CreateComment: insert failed", "err", insertErr, "blog_id", insertData.BlogID)
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Interal error: %v", insertErr),
+		)
+	}
+	commentID, ok := resp.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Can not convert to ObjectID: %v", insertErr),
+		)
+	}
+	insertData.ID = commentID
+	return &blogpb.CreateCommentResponse{
+		Comment: commentItemToBlogpb(&insertData),
+	}, nil
+}
+
+func (s *server) ListComments(req *blogpb.ListCommentsRequest, stream blogpb.BlogService_ListCommentsServer) error {
+	findFilter := bson.M{"blog_id": req.GetBlogId()}
+	cur, err := s.commentsCollection.Find(stream.Context(), findFilter)
+	if err != nil {
+		return status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("internal error when find collection: %v", err),
+		)
+	}
+	defer cur.Close(stream.Context())
+	for cur.Next(stream.Context()) {
+		data := &commentItem{}
+		if err := cur.Decode(data); err != nil {
+			return status.Errorf(
+				codes.Internal,
+				fmt.Sprintf("unable to decode data : %v", err),
+			)
+		}
+		stream.Send(&blogpb.ListCommentsResponse{
+			Comment: commentItemToBlogpb(data),
+		})
+	}
+	if err := cur.Err(); err != nil {
+		return status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("internal error from cursor: %v", err),
+		)
+	}
+	return nil
+}
+
+func (s *server) DeleteComment(ctx context.Context, req *blogpb.DeleteCommentRequest) (*blogpb.DeleteCommentResponse, error) {
+	oID, parseErr := primitive.ObjectIDFromHex(req.GetCommentId())
+	if parseErr != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			fmt.Sprintf("Can not parse comment ID: %v", parseErr),
+		)
+	}
+	existing := &commentItem{}
+	switch err := s.commentsCollection.FindOne(ctx, bson.M{"_id": oID}).Decode(existing); err {
+	case nil:
+		if err := requireAuthorOrAdmin(ctx, existing.AuthorID); err != nil {
+			return nil, err
+		}
+	case mongo.ErrNoDocuments:
+		// Nothing to authorize against; DeleteOne below removes 0 rows.
+	default:
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Can not delete comment : %v", err),
+		)
+	}
+
+	res, deleteErr := s.commentsCollection.DeleteOne(ctx, bson.M{"_id": oID})
+	if deleteErr != nil {
+		return nil, status.Errorf(
+			codes.Internal,
+			fmt.Sprintf("Can not delete comment : %v", deleteErr),
+		)
+	}
+	if res.DeletedCount == 0 {
+		return nil, status.Errorf(
+			codes.NotFound,
+			fmt.Sprintf("Not found comment with provided ID"),
+		)
+	}
+	return &blogpb.DeleteCommentResponse{
+		CommentId: req.GetCommentId(),
+	}, nil
+}
+
+// WatchComments streams new comments for a blog as they are created. It
+// prefers a MongoDB change stream and falls back to a tailing poll when
+// change streams are unavailable (e.g. a standalone, non-replica-set
+// deployment).
+func (s *server) WatchComments(req *blogpb.WatchCommentsRequest, stream blogpb.BlogService_WatchCommentsServer) error {
+	ctx := stream.Context()
+	pipeline := mongo.Pipeline{
+		bson.D{{Key: "$match", Value: bson.D{
+			{Key: "fullDocument.blog_id", Value: req.GetBlogId()},
+			{Key: "operationType", Value: "insert"},
+		}}},
+	}
+	changeStream, err := s.commentsCollection.Watch(ctx, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+	if err != nil {
+		s.warn(ctx, "WatchComments: change streams unavailable, falling back to polling", "err", err, "blog_id", req.GetBlogId())
+		return watchCommentsByPolling(ctx, s.commentsCollection, req.GetBlogId(), stream)
+	}
+	defer changeStream.Close(ctx)
+
+	for changeStream.Next(ctx) {
+		var event struct {
+			FullDocument commentItem `bson:"fullDocument"`
+		}
+		if err := changeStream.Decode(&event); err != nil {
+			return status.Errorf(codes.Internal, fmt.Sprintf("unable to decode change event: %v", err))
+		}
+		if err := stream.Send(&blogpb.WatchCommentsResponse{
+			Comment: commentItemToBlogpb(&event.FullDocument),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := changeStream.Err(); err != nil {
+		return status.Errorf(codes.Internal, fmt.Sprintf("change stream error: %v", err))
+	}
+	return nil
+}
+
+// watchCommentsByPolling tails the comments collection for blogID on a fixed
+// interval, used when the deployment does not support change streams.
+func watchCommentsByPolling(ctx context.Context, commentsCollection *mongo.Collection, blogID string, stream blogpb.BlogService_WatchCommentsServer) error {
+	const pollInterval = 2 * time.Second
+	lastSeen := time.Now().UTC()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			findFilter := bson.M{"blog_id": blogID, "created_at": bson.M{"$gt": lastSeen}}
+			findOpts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+			cur, err := commentsCollection.Find(ctx, findFilter, findOpts)
+			if err != nil {
+				return status.Errorf(codes.Internal, fmt.Sprintf("polling find failed: %v", err))
+			}
+			for cur.Next(ctx) {
+				data := &commentItem{}
+				if err := cur.Decode(data); err != nil {
+					cur.Close(ctx)
+					return status.Errorf(codes.Internal, fmt.Sprintf("unable to decode data : %v", err))
+				}
+				if err := stream.Send(&blogpb.WatchCommentsResponse{Comment: commentItemToBlogpb(data)}); err != nil {
+					cur.Close(ctx)
+					return err
+				}
+				lastSeen = data.CreatedAt
+			}
+			if err := cur.Err(); err != nil && err != io.EOF {
+				cur.Close(ctx)
+				return status.Errorf(codes.Internal, fmt.Sprintf("internal error from cursor: %v", err))
+			}
+			cur.Close(ctx)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/thogtq/grpc-blog-service/m/v1/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+type loggerCtxKeyType struct{}
+
+var loggerCtxKey = loggerCtxKeyType{}
+
+// newLogger builds a structured logger from cfg. Level and output format
+// are configurable via logging.level (debug|info|warn|error, default info)
+// and logging.format (json|text, default json) so operators can switch to
+// human-readable logs locally without a code change.
+func newLogger(cfg *config.LoggingConfig) *slog.Logger {
+	var level slog.Level
+	switch cfg.Level {
+	case "debug":
+		level = slog.LevelDebug
+	case "warn":
+		level = slog.LevelWarn
+	case "error":
+		level = slog.LevelError
+	default:
+		level = slog.LevelInfo
+	}
+
+	handlerOpts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	if cfg.Format == "text" {
+		handler = slog.NewTextHandler(os.Stdout, handlerOpts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stdout, handlerOpts)
+	}
+	return slog.New(handler)
+}
+
+func contextWithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey, l)
+}
+
+// loggerFromContext returns the per-request logger the interceptors
+// attached, or fallback if the context carries none (e.g. a call made
+// outside of gRPC request handling).
+func loggerFromContext(ctx context.Context, fallback *slog.Logger) *slog.Logger {
+	if l, ok := ctx.Value(loggerCtxKey).(*slog.Logger); ok {
+		return l
+	}
+	return fallback
+}
+
+func (s *server) info(ctx context.Context, msg string, args ...any) {
+	loggerFromContext(ctx, s.logger).Info(msg, args...)
+}
+
+func (s *server) warn(ctx context.Context, msg string, args ...any) {
+	loggerFromContext(ctx, s.logger).Warn(msg, args...)
+}
+
+func (s *server) error(ctx context.Context, msg string, args ...any) {
+	loggerFromContext(ctx, s.logger).Error(msg, args...)
+}
+
+func newRequestID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
+
+func peerAddr(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+// loggingUnaryInterceptor attaches a per-request logger (carrying method,
+// peer and request_id) to the context so handlers can log via s.info/s.warn/
+// s.error, and records the outcome and elapsed time of every unary RPC.
+func loggingUnaryInterceptor(base *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestLogger := base.With(
+			"method", info.FullMethod,
+			"peer", peerAddr(ctx),
+			"request_id", newRequestID(),
+		)
+		ctx = contextWithLogger(ctx, requestLogger)
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		elapsed := time.Since(start)
+
+		if err != nil {
+			requestLogger.Warn("rpc failed", "elapsed", elapsed, "err", err)
+		} else {
+			requestLogger.Info("rpc completed", "elapsed", elapsed)
+		}
+		return resp, err
+	}
+}
+
+// loggedServerStream wraps grpc.ServerStream to hand handlers a context that
+// carries the per-request logger, mirroring the unary interceptor.
+type loggedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func loggingStreamInterceptor(base *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestLogger := base.With(
+			"method", info.FullMethod,
+			"peer", peerAddr(ss.Context()),
+			"request_id", newRequestID(),
+		)
+		wrapped := &loggedServerStream{ServerStream: ss, ctx: contextWithLogger(ss.Context(), requestLogger)}
+
+		start := time.Now()
+		err := handler(srv, wrapped)
+		elapsed := time.Since(start)
+
+		if err != nil {
+			requestLogger.Warn("stream rpc failed", "elapsed", elapsed, "err", err)
+		} else {
+			requestLogger.Info("stream rpc completed", "elapsed", elapsed)
+		}
+		return err
+	}
+}
@@ -0,0 +1,180 @@
+// Package migrations applies ordered, idempotent schema changes to MongoDB
+// at startup (or out of band via --migrate-only), tracking which versions
+// have already run in a schema_migrations collection.
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Migration is a single versioned, idempotent schema change. Up must be safe
+// to re-run: CreateMany on an already-existing index with the same keys and
+// name is a no-op, which is what every migration below relies on.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database, blogCollection string) error
+}
+
+// registered lists every migration in the order it must be applied. New
+// migrations are appended with the next Version; existing entries must
+// never be renumbered or rewritten once released.
+var registered = []Migration{
+	{1, "create_blog_indexes", createBlogIndexes},
+	{2, "create_comment_indexes", createCommentIndexes},
+	{3, "create_follower_indexes", createFollowerIndexes},
+}
+
+type appliedMigration struct {
+	Version   int       `bson:"_id"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+const (
+	schemaMigrationsCollection = "schema_migrations"
+	lockCollectionName         = "schema_migration_locks"
+	lockDocumentID             = "schema_migrations"
+	lockMaxAttempts            = 30
+	lockRetryInterval          = time.Second
+	// lockMaxAge bounds how long a lock document is honored. It must be
+	// comfortably longer than any real migration run, so that a crashed
+	// runner that left the lock behind is reclaimed rather than wedging
+	// every future deploy until someone deletes the document by hand.
+	lockMaxAge = 10 * time.Minute
+)
+
+// Run applies every migration in registered that has not yet recorded an
+// entry in schema_migrations, under a distributed lock so that multiple
+// replicas starting up at once do not race to apply the same migration.
+// blogCollection is the configured name of the blog collection, since it is
+// not necessarily "blog".
+func Run(ctx context.Context, db *mongo.Database, blogCollection string) error {
+	release, err := acquireLock(ctx, db)
+	if err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer release()
+
+	collection := db.Collection(schemaMigrationsCollection)
+	applied := map[int]bool{}
+	cur, err := collection.Find(ctx, bson.M{})
+	if err != nil {
+		return fmt.Errorf("list applied migrations: %w", err)
+	}
+	defer cur.Close(ctx)
+	for cur.Next(ctx) {
+		var rec appliedMigration
+		if err := cur.Decode(&rec); err != nil {
+			return fmt.Errorf("decode applied migration: %w", err)
+		}
+		applied[rec.Version] = true
+	}
+	if err := cur.Err(); err != nil {
+		return fmt.Errorf("list applied migrations: %w", err)
+	}
+
+	for _, m := range registered {
+		if applied[m.Version] {
+			continue
+		}
+		if err := m.Up(ctx, db, blogCollection); err != nil {
+			return fmt.Errorf("migration %d (%v): %w", m.Version, m.Name, err)
+		}
+		_, err := collection.InsertOne(ctx, appliedMigration{Version: m.Version, AppliedAt: time.Now()})
+		if err != nil {
+			return fmt.Errorf("record migration %d (%v) as applied: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// acquireLock takes the single schema_migrations lock document, retrying
+// until another runner releases it, its locked_at goes stale, or
+// lockMaxAttempts is exhausted. The returned func releases the lock and must
+// always be called.
+func acquireLock(ctx context.Context, db *mongo.Database) (func(), error) {
+	lockCollection := db.Collection(lockCollectionName)
+	release := func() {
+		lockCollection.DeleteOne(context.Background(), bson.M{"_id": lockDocumentID})
+	}
+
+	for attempt := 1; attempt <= lockMaxAttempts; attempt++ {
+		now := time.Now()
+		_, err := lockCollection.InsertOne(ctx, bson.M{"_id": lockDocumentID, "locked_at": now})
+		if err == nil {
+			return release, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+
+		// Someone else holds the lock. If it is older than lockMaxAge, the
+		// runner that took it likely crashed before releasing it: steal it
+		// atomically instead of waiting out every remaining attempt.
+		result, stealErr := lockCollection.ReplaceOne(ctx,
+			bson.M{"_id": lockDocumentID, "locked_at": bson.M{"$lt": now.Add(-lockMaxAge)}},
+			bson.M{"_id": lockDocumentID, "locked_at": now},
+		)
+		if stealErr != nil {
+			return nil, stealErr
+		}
+		if result.ModifiedCount == 1 {
+			return release, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(lockRetryInterval):
+		}
+	}
+	return nil, fmt.Errorf("migration lock held by another runner after %v attempts", lockMaxAttempts)
+}
+
+func createBlogIndexes(ctx context.Context, db *mongo.Database, blogCollection string) error {
+	_, err := db.Collection(blogCollection).Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "author_id", Value: 1}},
+			Options: options.Index().SetName("author_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "title", Value: "text"}, {Key: "content", Value: "text"}},
+			Options: options.Index().SetName("title_content_text"),
+		},
+		{
+			Keys:    bson.D{{Key: "_id", Value: 1}, {Key: "author_id", Value: 1}},
+			Options: options.Index().SetName("id_author_id"),
+		},
+	})
+	return err
+}
+
+func createCommentIndexes(ctx context.Context, db *mongo.Database, blogCollection string) error {
+	_, err := db.Collection("comments").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "blog_id", Value: 1}},
+			Options: options.Index().SetName("blog_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "parent_comment_id", Value: 1}},
+			Options: options.Index().SetName("parent_comment_id"),
+		},
+	})
+	return err
+}
+
+func createFollowerIndexes(ctx context.Context, db *mongo.Database, blogCollection string) error {
+	_, err := db.Collection("followers").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "author_id", Value: 1}, {Key: "actor_iri", Value: 1}},
+			Options: options.Index().SetName("author_id_actor_iri").SetUnique(true),
+		},
+	})
+	return err
+}